@@ -0,0 +1,23 @@
+// Package cache define um armazenamento de Endereco com expiração por TTL,
+// usado para evitar refazer a corrida entre provedores a cada consulta do
+// mesmo CEP.
+package cache
+
+import (
+	"time"
+
+	"github.com/willianfariabatista/janeiro_Desafio2_Multithreading/domain"
+)
+
+// Cache é implementado por qualquer armazenamento capaz de guardar Enderecos
+// resolvidos por tempo limitado. Implementações devem ser seguras para uso
+// concorrente. O adaptador padrão é LRU; um adaptador Redis pode implementar
+// a mesma interface para compartilhar o cache entre instâncias do serviço.
+type Cache interface {
+	// Get retorna o Endereco armazenado para key e um booleano indicando se
+	// havia uma entrada presente e ainda não expirada.
+	Get(key string) (domain.Endereco, bool)
+
+	// Set grava endereco sob key, válido pelos próximos ttl.
+	Set(key string, endereco domain.Endereco, ttl time.Duration)
+}
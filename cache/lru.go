@@ -0,0 +1,83 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/willianfariabatista/janeiro_Desafio2_Multithreading/domain"
+)
+
+// lruEntry é o valor guardado em cada elemento da lista de uso do LRU.
+type lruEntry struct {
+	key      string
+	endereco domain.Endereco
+	expira   time.Time
+}
+
+// LRU é a implementação de Cache em memória usada por padrão pelo serviço:
+// mantém até capacity entradas, descartando a usada há mais tempo quando
+// cheia, e ignora (tratando como ausente) entradas cujo TTL já expirou.
+type LRU struct {
+	mu       sync.Mutex
+	capacity int
+	items    map[string]*list.Element
+	order    *list.List // Frente = mais recentemente usado.
+}
+
+// NewLRU cria um LRU com a capacidade informada.
+func NewLRU(capacity int) *LRU {
+	return &LRU{
+		capacity: capacity,
+		items:    make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// Get retorna o Endereco guardado para key, se houver e ainda não tiver expirado.
+func (c *LRU) Get(key string) (domain.Endereco, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.items[key]
+	if !ok {
+		return domain.Endereco{}, false
+	}
+
+	entry := elem.Value.(*lruEntry)
+	if time.Now().After(entry.expira) {
+		c.order.Remove(elem)
+		delete(c.items, key)
+		return domain.Endereco{}, false
+	}
+
+	c.order.MoveToFront(elem)
+	return entry.endereco, true
+}
+
+// Set grava endereco sob key, válido pelos próximos ttl, evictando a entrada
+// menos recentemente usada se a capacidade for excedida.
+func (c *LRU) Set(key string, endereco domain.Endereco, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expira := time.Now().Add(ttl)
+
+	if elem, ok := c.items[key]; ok {
+		elem.Value.(*lruEntry).endereco = endereco
+		elem.Value.(*lruEntry).expira = expira
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	elem := c.order.PushFront(&lruEntry{key: key, endereco: endereco, expira: expira})
+	c.items[key] = elem
+
+	if c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		if oldest != nil {
+			c.order.Remove(oldest)
+			delete(c.items, oldest.Value.(*lruEntry).key)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/willianfariabatista/janeiro_Desafio2_Multithreading/domain"
+)
+
+func TestLRUGetMiss(t *testing.T) {
+	c := NewLRU(2)
+	if _, ok := c.Get("x"); ok {
+		t.Fatal("esperava miss em cache vazio")
+	}
+}
+
+func TestLRUSetAndGet(t *testing.T) {
+	c := NewLRU(2)
+	end := domain.Endereco{CEP: "123", Fonte: "Test"}
+	c.Set("123", end, time.Minute)
+
+	got, ok := c.Get("123")
+	if !ok {
+		t.Fatal("esperava hit")
+	}
+	if got != end {
+		t.Fatalf("got %+v, want %+v", got, end)
+	}
+}
+
+func TestLRUExpiry(t *testing.T) {
+	c := NewLRU(2)
+	c.Set("123", domain.Endereco{CEP: "123"}, time.Millisecond)
+	time.Sleep(5 * time.Millisecond)
+
+	if _, ok := c.Get("123"); ok {
+		t.Fatal("esperava que a entrada tivesse expirado")
+	}
+}
+
+func TestLRUEviction(t *testing.T) {
+	c := NewLRU(2)
+	c.Set("a", domain.Endereco{CEP: "a"}, time.Minute)
+	c.Set("b", domain.Endereco{CEP: "b"}, time.Minute)
+	c.Set("c", domain.Endereco{CEP: "c"}, time.Minute) // deve evictar "a"
+
+	if _, ok := c.Get("a"); ok {
+		t.Fatal("esperava que a fosse evictada")
+	}
+	if _, ok := c.Get("b"); !ok {
+		t.Fatal("esperava que b ainda estivesse presente")
+	}
+	if _, ok := c.Get("c"); !ok {
+		t.Fatal("esperava que c estivesse presente")
+	}
+}
+
+func TestLRURecentUseProtectsFromEviction(t *testing.T) {
+	c := NewLRU(2)
+	c.Set("a", domain.Endereco{CEP: "a"}, time.Minute)
+	c.Set("b", domain.Endereco{CEP: "b"}, time.Minute)
+	c.Get("a")                                         // toca a, tornando b a menos recentemente usada
+	c.Set("c", domain.Endereco{CEP: "c"}, time.Minute) // deve evictar b, não a
+
+	if _, ok := c.Get("b"); ok {
+		t.Fatal("esperava que b fosse evictada")
+	}
+	if _, ok := c.Get("a"); !ok {
+		t.Fatal("esperava que a ainda estivesse presente")
+	}
+}
+
+func TestLRUUpdateInPlace(t *testing.T) {
+	c := NewLRU(2)
+	c.Set("a", domain.Endereco{CEP: "a", Logradouro: "Rua 1"}, time.Minute)
+	c.Set("a", domain.Endereco{CEP: "a", Logradouro: "Rua 2"}, time.Minute)
+
+	got, ok := c.Get("a")
+	if !ok {
+		t.Fatal("esperava hit")
+	}
+	if got.Logradouro != "Rua 2" {
+		t.Fatalf("got %q, want %q", got.Logradouro, "Rua 2")
+	}
+}
@@ -0,0 +1,13 @@
+// Package domain contém os tipos centrais compartilhados pelos provedores,
+// pelo racer e pelos handlers HTTP do serviço de consulta de CEP.
+package domain
+
+// Endereco representa o endereço retornado por um provedor de consulta de CEP.
+type Endereco struct {
+	CEP        string `json:"cep"`
+	Logradouro string `json:"logradouro"`
+	Bairro     string `json:"bairro"`
+	Cidade     string `json:"cidade"`
+	UF         string `json:"uf"`
+	Fonte      string `json:"fonte"` // Nome do provedor que retornou este endereço.
+}
@@ -0,0 +1,13 @@
+package domain
+
+// ResultadoAgregado é a resposta do modo "all": reúne o Endereco devolvido por
+// cada provedor que respondeu com sucesso e aponta os campos em que os
+// provedores divergem entre si.
+type ResultadoAgregado struct {
+	Enderecos []Endereco `json:"enderecos"`
+
+	// Discrepancias mapeia o nome do campo (ex.: "Logradouro") ao valor
+	// retornado por cada provedor, e só contém entradas para campos em que os
+	// provedores discordam.
+	Discrepancias map[string]map[string]string `json:"discrepancias,omitempty"`
+}
@@ -0,0 +1,142 @@
+// Package handlers expõe o serviço de consulta de CEP via HTTP.
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	"github.com/willianfariabatista/janeiro_Desafio2_Multithreading/cache"
+	"github.com/willianfariabatista/janeiro_Desafio2_Multithreading/domain"
+	"github.com/willianfariabatista/janeiro_Desafio2_Multithreading/providers"
+)
+
+// Timeout padrão de uma consulta de CEP, caso CEPHandler.Timeout não seja definido.
+const defaultTimeout = 1 * time.Second
+
+// TTL padrão de uma entrada em cache, caso CEPHandler.TTL não seja definido.
+const defaultTTL = 24 * time.Hour
+
+// Capacidade padrão do cache em memória criado por NewCEPHandler.
+const defaultCacheCapacity = 10_000
+
+// CEPHandler atende requisições HTTP de consulta de CEP usando um Racer. O
+// modo corrida (padrão) passa pelo Cache e por um singleflight.Group, de modo
+// que buscas concorrentes pelo mesmo CEP colapsam em uma única corrida entre
+// provedores.
+type CEPHandler struct {
+	Racer   *providers.Racer
+	Timeout time.Duration // Prazo máximo da corrida entre provedores. Zero usa defaultTimeout.
+	Cache   cache.Cache   // Se nil, o cache é desabilitado.
+	TTL     time.Duration // TTL usado ao gravar no Cache. Zero usa defaultTTL.
+
+	group singleflight.Group
+}
+
+// NewCEPHandler cria um CEPHandler que consulta o Racer informado, com um
+// cache LRU em memória habilitado por padrão.
+func NewCEPHandler(racer *providers.Racer) *CEPHandler {
+	return &CEPHandler{
+		Racer:   racer,
+		Timeout: defaultTimeout,
+		Cache:   cache.NewLRU(defaultCacheCapacity),
+		TTL:     defaultTTL,
+	}
+}
+
+// ServeHTTP atende GET /cep/{cep}. No modo corrida (padrão) responde com o
+// Endereco em cache ou, se não houver, com o primeiro Endereco encontrado pela
+// busca concorrente nos provedores registrados; com ?mode=all responde com o
+// ResultadoAgregado de todos os provedores.
+func (h *CEPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+
+	if r.Method != http.MethodGet {
+		http.Error(w, "método não permitido", http.StatusMethodNotAllowed)
+		return
+	}
+
+	cep := normalizarCEP(strings.TrimPrefix(r.URL.Path, "/cep/"))
+	if cep == "" || cep == r.URL.Path {
+		http.Error(w, "CEP não informado", http.StatusBadRequest)
+		return
+	}
+
+	timeout := h.Timeout
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), timeout)
+	defer cancel()
+
+	ttl := h.TTL
+	if ttl == 0 {
+		ttl = defaultTTL
+	}
+
+	// mode=all aguarda todos os provedores e reporta divergências entre eles,
+	// em vez de retornar apenas o primeiro que responder. Não passa pelo cache,
+	// que só guarda o Endereco único do modo corrida.
+	if r.URL.Query().Get("mode") == "all" {
+		resultado, err := h.Racer.RaceAll(ctx, cep)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusGatewayTimeout)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resultado)
+		return
+	}
+
+	if h.Cache != nil {
+		if endereco, ok := h.Cache.Get(cep); ok {
+			cacheHitsTotal.Inc()
+			w.Header().Set("X-Cache", "HIT")
+			w.Header().Set("Cache-Control", "max-age=0")
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(endereco)
+			return
+		}
+		cacheMissesTotal.Inc()
+	}
+
+	// singleflight colapsa buscas concorrentes pelo mesmo CEP em uma única
+	// corrida entre provedores, evitando consultas redundantes às APIs. A
+	// corrida roda sob um contexto próprio, não o de nenhum chamador
+	// específico: se usássemos r.Context(), o desconecte do primeiro
+	// chamador a entrar no grupo cancelaria a busca para todos os demais
+	// que colapsaram na mesma chave, mesmo com a conexão deles ainda viva.
+	raceCtx, raceCancel := context.WithTimeout(context.Background(), timeout)
+	defer raceCancel()
+
+	v, err, _ := h.group.Do(cep, func() (interface{}, error) {
+		return h.Racer.Race(raceCtx, cep)
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusGatewayTimeout)
+		return
+	}
+	endereco := v.(domain.Endereco)
+
+	if h.Cache != nil {
+		h.Cache.Set(cep, endereco, ttl)
+	}
+
+	w.Header().Set("X-Cache", "MISS")
+	w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", int(ttl.Seconds())))
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(endereco)
+}
+
+// normalizarCEP remove os espaços nas pontas e todos os hifens de um CEP, usada como chave de cache e
+// de singleflight para que "01310-100" e "01310100" colapsem na mesma entrada.
+func normalizarCEP(cep string) string {
+	cep = strings.TrimSpace(cep)
+	cep = strings.ReplaceAll(cep, "-", "")
+	return cep
+}
@@ -0,0 +1,20 @@
+package handlers
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	// cacheHitsTotal conta as consultas de CEP atendidas a partir do cache.
+	cacheHitsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "cep_cache_hits_total",
+		Help: "Total de consultas de CEP atendidas a partir do cache.",
+	})
+
+	// cacheMissesTotal conta as consultas de CEP que precisaram acionar o Racer.
+	cacheMissesTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "cep_cache_misses_total",
+		Help: "Total de consultas de CEP não encontradas no cache.",
+	})
+)
@@ -2,222 +2,70 @@ package main
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
+	"errors"
+	"flag"
 	"log"
 	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
-)
-
-// Estrutura que representa a o Endereço.
-type Endereco struct {
-	CEP        string // Código de Endereçamento Postal.
-	Logradouro string // Nome da rua ou avenida.
-	Bairro     string // Nome do bairro.
-	Cidade     string // Nome da cidade.
-	UF         string // Unidade Federativa (Estado).
-	Fonte      string // Para indicar qual API retornou mais rápido.
-}
-
-// Estrutura para decodificar a resposta da API: BrasilAPI.
-type brasilAPIResponse struct {
-	Cep          string `json:"cep"`
-	State        string `json:"state"`
-	City         string `json:"city"`
-	Neighborhood string `json:"neighborhood"`
-	Street       string `json:"street"`
-}
-
-// Estrutura para decodificar a resposta da API: ViaCEP.
-type viaCEPResponse struct {
-	Cep        string `json:"cep"`
-	Logradouro string `json:"logradouro"`
-	Bairro     string `json:"bairro"`
-	Localidade string `json:"localidade"`
-	Uf         string `json:"uf"`
-}
-
-// buscaBrasilAPI faz a requisição para a brasilapi.com.br.
-func buscaBrasilAPI(ctx context.Context, cep string) (Endereco, error) {
-
-	endereco := Endereco{}
-	url := fmt.Sprintf("https://brasilapi.com.br/api/cep/v1/%s", cep)
-
-	// Cria uma requisição HTTP GET com um contexto para buscar o endereço na API brasilapi.com.br.
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
-
-	// Se houver erro na criação da requisição, retorna a estrutura de endereço vazia e o erro.
-	if err != nil {
-		return endereco, err
-	}
-
-	// Envia a requisição HTTP para obter os dados do endereço.
-	resp, err := http.DefaultClient.Do(req)
-
-	// Se houver erro na requisição, retorna a estrutura de endereço vazia e o erro.
-	if err != nil {
-		return endereco, err
-	}
-
-	// Garante que o corpo da resposta HTTP seja fechado.
-	defer resp.Body.Close()
-
-	// Verifica se a resposta HTTP tem status diferente de 200 (OK). Se for diferente, retorna a estrutura de endereço vazia e uma mensagem de erro.
-	if resp.StatusCode != http.StatusOK {
-		return endereco, fmt.Errorf("BrasilAPI retornou status %d", resp.StatusCode)
-	}
-
-	var data brasilAPIResponse
-
-	// Decodifica o JSON da resposta HTTP para a estrutura brasilAPIResponse. Se ocorrer um erro na decodificação, retorna a estrutura de endereço vazia e o erro.
-	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
-		return endereco, err
-	}
-
-	// Preenche a estrutura Endereco com os dados retornados pela API brasilapi.com.br.
-	// Atribui os valores correspondentes do JSON recebido à estrutura Endereco.
-	endereco = Endereco{
-		CEP:        data.Cep,
-		Logradouro: data.Street,
-		Bairro:     data.Neighborhood,
-		Cidade:     data.City,
-		UF:         data.State,
-		Fonte:      "BrasilAPI",
-	}
-
-	// Retorna a estrutura Endereco preenchida.
-	return endereco, nil
-}
-
-// buscaViaCEP faz a requisição para a API: viacep.com.br.
-func buscaViaCEP(ctx context.Context, cep string) (Endereco, error) {
-
-	endereco := Endereco{}
-	url := fmt.Sprintf("http://viacep.com.br/ws/%s/json/", cep)
-
-	// Cria uma requisição HTTP GET com um contexto para buscar o endereço na API viacep.com.br.
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
-
-	// Se houver erro na criação da requisição, retorna a estrutura de endereço vazia e o erro.
-	if err != nil {
-		return endereco, err
-	}
-
-	// Envia a requisição HTTP para obter os dados do endereço.
-	resp, err := http.DefaultClient.Do(req)
 
-	// Se houver erro na requisição, retorna a estrutura de endereço vazia e o erro.
-	if err != nil {
-		return endereco, err
-	}
-
-	// Garante que o corpo da resposta HTTP seja fechado.
-	defer resp.Body.Close()
-
-	// Verifica se a resposta HTTP tem status diferente de 200 (OK). Se for diferente, retorna a estrutura de endereço vazia e uma mensagem de erro.
-	if resp.StatusCode != http.StatusOK {
-		return endereco, fmt.Errorf("ViaCEP retornou status %d", resp.StatusCode)
-	}
-
-	var data viaCEPResponse
-
-	// Decodifica o JSON da resposta HTTP para a estrutura viaCEPResponse. Se ocorrer um erro na decodificação, retorna a estrutura de endereço vazia e o erro.
-	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
-		return endereco, err
-	}
-
-	// Preenche a estrutura Endereco com os dados retornados pela API viacep.com.br.
-	// Atribui os valores correspondentes do JSON recebido à estrutura Endereco.
-	endereco = Endereco{
-		CEP:        data.Cep,
-		Logradouro: data.Logradouro,
-		Bairro:     data.Bairro,
-		Cidade:     data.Localidade,
-		UF:         data.Uf,
-		Fonte:      "ViaCEP",
-	}
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/willianfariabatista/janeiro_Desafio2_Multithreading/handlers"
+	"github.com/willianfariabatista/janeiro_Desafio2_Multithreading/providers"
+)
 
-	// Retorna a estrutura Endereco preenchida.
-	return endereco, nil
-}
+var drainTimeout = flag.Duration("drain-timeout", 10*time.Second,
+	"tempo máximo de espera por requisições em andamento antes de forçar o encerramento")
 
 func main() {
+	flag.Parse()
 
-	// Defina o CEP que deseja consultar.
-	cep := "06341650"
-
-	// Cria um contexto com timeout de 1 segundo para limitar o tempo da requisição HTTP.
-	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+	// Provedores registrados no racer. Para adicionar um novo (Correios,
+	// ApiCEP, ...), basta implementar providers.Provider e incluí-lo aqui.
+	racer := providers.NewRacer(
+		providers.BrasilAPI{},
+		providers.ViaCEP{},
+	)
 
-	// Garante que o contexto sejam liberados após a execução.
-	defer cancel()
-
-	// Canal para receber o resultado de qualquer uma das APIs.
-	resultChan := make(chan Endereco, 2)
+	mux := http.NewServeMux()
+	mux.Handle("/cep/", handlers.NewCEPHandler(racer))
+	mux.Handle("/metrics", promhttp.Handler())
 
-	// Armazena erros que podem ocorrer nas requisições.
-	errChan := make(chan error, 2)
+	server := &http.Server{Addr: ":8080", Handler: mux}
 
-	// Inicia duas goroutines para buscar o endereço em APIs diferentes de forma concorrente.
 	go func() {
-
-		// Goroutine para buscar o endereço na BrasilAPI.
-		end, err := buscaBrasilAPI(ctx, cep)
-		if err != nil {
-
-			// Envia o erro para o canal de erros se a requisição falhar.
-			errChan <- err
-			return
+		log.Printf("Servindo consulta de CEP em %s\n", server.Addr)
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("erro ao iniciar servidor: %v\n", err)
 		}
-
-		// Envia o resultado para o canal de resultados.
-		resultChan <- end
-	}()
-
-	go func() {
-		// Goroutine para buscar o endereço na ViaCEP.
-		end, err := buscaViaCEP(ctx, cep)
-		if err != nil {
-
-			// Envia o erro para o canal de erros se a requisição falhar.
-			errChan <- err
-			return
-		}
-
-		// Envia o resultado para o canal de resultados.
-		resultChan <- end
 	}()
 
-	// Select para pegar o resultado da primeira que chegar.
-	select {
-
-	// Caso o contexto expire (timeout atingido), interrompe a espera por respostas.
-	case <-ctx.Done():
-
-		// Se o contexto expirar (timeout de 1s), exibimos erro
-		log.Println("Timeout: Nenhuma resposta recebida em 1 segundo.")
-
-	// Recebe o primeiro resultado disponível no canal de respostas.
-	case end := <-resultChan:
-
-		// Se um resultado for recebido antes do timeout, cancela o contexto para interromper a outra requisição.
-		cancel()
-
-		// Registra no log os detalhes do endereço recebido da API que respondeu mais rápido.
-		log.Printf("Resultado recebido da %s:\n", end.Fonte)
-		log.Printf("CEP: %s\nLogradouro: %s\nBairro: %s\nCidade: %s\nUF: %s\n",
-			end.CEP,
-			end.Logradouro,
-			end.Bairro,
-			end.Cidade,
-			end.UF,
-		)
+	aguardarSinalEDrenar(server, *drainTimeout)
+}
 
-	// Recebe um erro do canal de erros caso a requisição falhe.
-	case err := <-errChan:
+// aguardarSinalEDrenar bloqueia até receber SIGINT/SIGTERM e então para de
+// aceitar novas conexões, mas dá às requisições em andamento (e às goroutines
+// de provedores que elas disparam) até drainTimeout para terminar. Se o prazo
+// expirar, força o fechamento das conexões restantes, cancelando o contexto
+// das requisições ainda em voo. As buscas do modo corrida rodam sob um
+// contexto próprio do CEPHandler, limitado apenas por seu Timeout: o
+// fechamento não as cancela.
+func aguardarSinalEDrenar(server *http.Server, drainTimeout time.Duration) {
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+	<-stop
+
+	log.Println("Sinal de encerramento recebido, drenando requisições em andamento...")
+
+	ctx, cancel := context.WithTimeout(context.Background(), drainTimeout)
+	defer cancel()
 
-		// Se um erro for recebido, cancela o contexto e exibe a mensagem de erro no log.
-		cancel()
-		log.Printf("Erro ao consultar: %v\n", err)
+	if err := server.Shutdown(ctx); err != nil {
+		log.Printf("prazo de drenagem (%s) excedido, forçando encerramento: %v\n", drainTimeout, err)
+		server.Close()
 	}
+
+	log.Println("Servidor encerrado.")
 }
@@ -0,0 +1,88 @@
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/willianfariabatista/janeiro_Desafio2_Multithreading/domain"
+)
+
+// brasilAPIResponse representa a resposta da API: BrasilAPI.
+type brasilAPIResponse struct {
+	Cep          string `json:"cep"`
+	State        string `json:"state"`
+	City         string `json:"city"`
+	Neighborhood string `json:"neighborhood"`
+	Street       string `json:"street"`
+}
+
+// brasilAPIClient é usado quando BrasilAPI.Client não é informado.
+var brasilAPIClient = NewClient(DefaultClientConfig())
+
+// BrasilAPI consulta o endereço de um CEP na brasilapi.com.br.
+type BrasilAPI struct {
+	Client *Client // Se nil, usa um Client com ClientConfig padrão.
+}
+
+// Nome identifica este provedor.
+func (BrasilAPI) Nome() string {
+	return "BrasilAPI"
+}
+
+// Fetch faz a requisição para a brasilapi.com.br.
+func (p BrasilAPI) Fetch(ctx context.Context, cep string) (domain.Endereco, error) {
+
+	client := p.Client
+	if client == nil {
+		client = brasilAPIClient
+	}
+
+	endereco := domain.Endereco{}
+	url := fmt.Sprintf("https://brasilapi.com.br/api/cep/v1/%s", cep)
+
+	// Cria uma requisição HTTP GET com um contexto para buscar o endereço na API brasilapi.com.br.
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+
+	// Se houver erro na criação da requisição, retorna a estrutura de endereço vazia e o erro.
+	if err != nil {
+		return endereco, err
+	}
+
+	// Envia a requisição HTTP para obter os dados do endereço, com retry/backoff e rate limiting.
+	resp, err := client.Do(req)
+
+	// Se houver erro na requisição, retorna a estrutura de endereço vazia e o erro.
+	if err != nil {
+		return endereco, err
+	}
+
+	// Garante que o corpo da resposta HTTP seja fechado.
+	defer resp.Body.Close()
+
+	// Verifica se a resposta HTTP tem status diferente de 200 (OK). Se for diferente, retorna a estrutura de endereço vazia e uma mensagem de erro.
+	if resp.StatusCode != http.StatusOK {
+		return endereco, fmt.Errorf("BrasilAPI retornou status %d", resp.StatusCode)
+	}
+
+	var data brasilAPIResponse
+
+	// Decodifica o JSON da resposta HTTP para a estrutura brasilAPIResponse. Se ocorrer um erro na decodificação, retorna a estrutura de endereço vazia e o erro.
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		return endereco, err
+	}
+
+	// Preenche a estrutura Endereco com os dados retornados pela API brasilapi.com.br.
+	endereco = domain.Endereco{
+		CEP:        data.Cep,
+		Logradouro: data.Street,
+		Bairro:     data.Neighborhood,
+		Cidade:     data.City,
+		UF:         data.State,
+		Fonte:      p.Nome(),
+	}
+
+	// Retorna a estrutura Endereco preenchida.
+	return endereco, nil
+}
@@ -0,0 +1,104 @@
+package providers
+
+import (
+	"math/rand"
+	"net/http"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// ClientConfig configura o comportamento de retry e de limitação de taxa de um Client.
+type ClientConfig struct {
+	MaxRetries int           // Número máximo de novas tentativas após a primeira requisição.
+	MinBackoff time.Duration // Atraso inicial entre tentativas.
+	MaxBackoff time.Duration // Teto do atraso exponencial.
+	RPS        float64       // Requisições por segundo permitidas para este provedor.
+	Burst      int           // Rajada permitida pelo limitador de taxa.
+
+	// RetryLogHook, se definido, é chamado antes de cada nova tentativa com o
+	// número da tentativa (1 = primeira retentativa) e a resposta/erro que a motivou.
+	RetryLogHook func(attempt int, resp *http.Response, err error)
+}
+
+// DefaultClientConfig retorna uma configuração conservadora adequada à maioria dos provedores.
+func DefaultClientConfig() ClientConfig {
+	return ClientConfig{
+		MaxRetries: 3,
+		MinBackoff: 100 * time.Millisecond,
+		MaxBackoff: 2 * time.Second,
+		RPS:        5,
+		Burst:      5,
+	}
+}
+
+// Client é um http.Client com retry exponencial com jitter em erros de rede e
+// respostas 5xx, mais um limitador de taxa por provedor.
+type Client struct {
+	cfg     ClientConfig
+	limiter *rate.Limiter
+	http    *http.Client
+}
+
+// NewClient cria um Client a partir da configuração informada.
+func NewClient(cfg ClientConfig) *Client {
+	return &Client{
+		cfg:     cfg,
+		limiter: rate.NewLimiter(rate.Limit(cfg.RPS), cfg.Burst),
+		http:    &http.Client{},
+	}
+}
+
+// Do executa req, aplicando o limitador de taxa e repetindo em caso de erro de
+// rede ou status 5xx, com backoff exponencial e jitter. A requisição ainda
+// honra o context.Context de req: se ele for cancelado (por exemplo, porque
+// outro provedor já venceu a corrida), Do retorna imediatamente.
+func (c *Client) Do(req *http.Request) (*http.Response, error) {
+
+	ctx := req.Context()
+
+	var resp *http.Response
+	var err error
+
+	for attempt := 0; ; attempt++ {
+
+		if waitErr := c.limiter.Wait(ctx); waitErr != nil {
+			return nil, waitErr
+		}
+
+		resp, err = c.http.Do(req)
+
+		retryable := err != nil || (resp != nil && resp.StatusCode >= http.StatusInternalServerError)
+		if !retryable || attempt >= c.cfg.MaxRetries {
+			return resp, err
+		}
+
+		if c.cfg.RetryLogHook != nil {
+			c.cfg.RetryLogHook(attempt+1, resp, err)
+		}
+
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(c.backoff(attempt)):
+		}
+	}
+}
+
+// backoff calcula o atraso exponencial com jitter para a tentativa informada
+// (0 = atraso antes da primeira retentativa), limitado a MaxBackoff.
+func (c *Client) backoff(attempt int) time.Duration {
+
+	delay := c.cfg.MinBackoff << attempt
+	if delay <= 0 || delay > c.cfg.MaxBackoff {
+		delay = c.cfg.MaxBackoff
+	}
+
+	// Jitter completo: sorteia um atraso entre 0 e o valor calculado para
+	// evitar que retentativas de múltiplos clientes se sincronizem.
+	return time.Duration(rand.Int63n(int64(delay) + 1))
+}
@@ -0,0 +1,40 @@
+package providers
+
+import "github.com/willianfariabatista/janeiro_Desafio2_Multithreading/domain"
+
+// compararEnderecos aponta, para cada campo de domain.Endereco relevante à
+// comparação, os valores divergentes retornados pelos provedores em
+// enderecos. Campos em que todos os provedores concordam (ou que só um
+// provedor preencheu) não aparecem no resultado.
+func compararEnderecos(enderecos []domain.Endereco) map[string]map[string]string {
+
+	campos := map[string]func(domain.Endereco) string{
+		"Logradouro": func(e domain.Endereco) string { return e.Logradouro },
+		"Bairro":     func(e domain.Endereco) string { return e.Bairro },
+		"Cidade":     func(e domain.Endereco) string { return e.Cidade },
+		"UF":         func(e domain.Endereco) string { return e.UF },
+	}
+
+	discrepancias := map[string]map[string]string{}
+
+	for campo, valorDe := range campos {
+
+		porProvedor := map[string]string{}
+		valores := map[string]bool{}
+
+		for _, e := range enderecos {
+			valor := valorDe(e)
+			if valor == "" {
+				continue
+			}
+			porProvedor[e.Fonte] = valor
+			valores[valor] = true
+		}
+
+		if len(valores) > 1 {
+			discrepancias[campo] = porProvedor
+		}
+	}
+
+	return discrepancias
+}
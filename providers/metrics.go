@@ -0,0 +1,35 @@
+package providers
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	// requestsTotal conta as requisições feitas a cada provedor, por status
+	// ("success", "error" ou "canceled").
+	requestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "cep_provider_requests_total",
+		Help: "Total de requisições feitas a cada provedor de CEP, por status.",
+	}, []string{"provider", "status"})
+
+	// requestDuration mede a latência de cada requisição a um provedor.
+	requestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "cep_provider_duration_seconds",
+		Help: "Duração das requisições a cada provedor de CEP.",
+	}, []string{"provider"})
+
+	// winsTotal conta quantas vezes o resultado de cada provedor foi o
+	// efetivamente consumido por uma busca em modo corrida.
+	winsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "cep_provider_wins_total",
+		Help: "Total de vezes que o resultado de um provedor foi o utilizado.",
+	}, []string{"provider"})
+
+	// lookupTimeoutsTotal conta as consultas de CEP que expiraram sem receber
+	// resposta de nenhum provedor a tempo.
+	lookupTimeoutsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "cep_lookup_timeouts_total",
+		Help: "Total de consultas de CEP que expiraram sem resposta de nenhum provedor.",
+	})
+)
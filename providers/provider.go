@@ -0,0 +1,21 @@
+// Package providers define o contrato Provider e as implementações que
+// consultam APIs externas de CEP.
+package providers
+
+import (
+	"context"
+
+	"github.com/willianfariabatista/janeiro_Desafio2_Multithreading/domain"
+)
+
+// Provider é implementado por qualquer fonte capaz de resolver um CEP em um Endereco.
+// Novos provedores (Correios, ApiCEP, ...) bastam implementar esta interface e
+// serem registrados na lista usada pelo Racer; nenhuma mudança no racer é necessária.
+type Provider interface {
+	// Nome identifica o provedor, usado em Endereco.Fonte, logs e métricas.
+	Nome() string
+
+	// Fetch busca o endereço correspondente ao CEP informado, respeitando o
+	// cancelamento/timeout do contexto.
+	Fetch(ctx context.Context, cep string) (domain.Endereco, error)
+}
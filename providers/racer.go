@@ -0,0 +1,139 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/willianfariabatista/janeiro_Desafio2_Multithreading/domain"
+)
+
+// fetchInstrumented chama p.Fetch registrando sua latência e status
+// ("success", "error" ou "canceled") nas métricas do provedor. Perder a
+// corrida cancela o contexto dos demais provedores, então esse caso é
+// contado à parte: caso contrário o contador de erro e o histograma de
+// latência ficariam poluídos por perdas normais, mascarando degradações reais.
+func fetchInstrumented(ctx context.Context, p Provider, cep string) (domain.Endereco, error) {
+
+	start := time.Now()
+	end, err := p.Fetch(ctx, cep)
+
+	switch {
+	case err == nil:
+		requestDuration.WithLabelValues(p.Nome()).Observe(time.Since(start).Seconds())
+		requestsTotal.WithLabelValues(p.Nome(), "success").Inc()
+
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		requestsTotal.WithLabelValues(p.Nome(), "canceled").Inc()
+
+	default:
+		requestDuration.WithLabelValues(p.Nome()).Observe(time.Since(start).Seconds())
+		requestsTotal.WithLabelValues(p.Nome(), "error").Inc()
+	}
+
+	return end, err
+}
+
+// Racer dispara a busca em todos os provedores registrados concorrentemente e
+// retorna o resultado do primeiro que responder com sucesso.
+type Racer struct {
+	Providers []Provider
+}
+
+// NewRacer cria um Racer para o conjunto de provedores informado. A ordem não
+// importa: todos são consultados em paralelo.
+func NewRacer(providers ...Provider) *Racer {
+	return &Racer{Providers: providers}
+}
+
+// Race consulta todos os provedores registrados concorrentemente e retorna o
+// primeiro Endereco recebido com sucesso. Assim que um vencedor é obtido, o
+// contexto filho é cancelado para interromper as demais buscas em andamento.
+// Retorna erro se o contexto expirar antes de qualquer provedor responder, ou
+// se todos os provedores falharem.
+func (r *Racer) Race(ctx context.Context, cep string) (domain.Endereco, error) {
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	resultChan := make(chan domain.Endereco, len(r.Providers))
+	errChan := make(chan error, len(r.Providers))
+
+	for _, provider := range r.Providers {
+		go func(p Provider) {
+			end, err := fetchInstrumented(ctx, p, cep)
+			if err != nil {
+				errChan <- err
+				return
+			}
+			resultChan <- end
+		}(provider)
+	}
+
+	var errs []error
+	for range r.Providers {
+		select {
+		case <-ctx.Done():
+			lookupTimeoutsTotal.Inc()
+			return domain.Endereco{}, ctx.Err()
+
+		case end := <-resultChan:
+			winsTotal.WithLabelValues(end.Fonte).Inc()
+			return end, nil
+
+		case err := <-errChan:
+			errs = append(errs, err)
+		}
+	}
+
+	return domain.Endereco{}, fmt.Errorf("todos os provedores falharam: %v", errs)
+}
+
+// RaceAll consulta todos os provedores registrados concorrentemente e aguarda
+// cada um deles responder (ou o ctx informado expirar). Ao contrário de Race,
+// não cancela os demais ao receber a primeira resposta: reúne o Endereco de
+// todo provedor que respondeu com sucesso e aponta divergências entre eles.
+// Retorna erro apenas se nenhum provedor responder com sucesso dentro do prazo.
+func (r *Racer) RaceAll(ctx context.Context, cep string) (domain.ResultadoAgregado, error) {
+
+	type resultado struct {
+		endereco domain.Endereco
+		err      error
+	}
+
+	results := make(chan resultado, len(r.Providers))
+
+	for _, provider := range r.Providers {
+		go func(p Provider) {
+			end, err := fetchInstrumented(ctx, p, cep)
+			results <- resultado{endereco: end, err: err}
+		}(provider)
+	}
+
+	var enderecos []domain.Endereco
+loop:
+	for range r.Providers {
+		select {
+		case <-ctx.Done():
+			if len(enderecos) == 0 {
+				lookupTimeoutsTotal.Inc()
+			}
+			break loop
+
+		case res := <-results:
+			if res.err == nil {
+				enderecos = append(enderecos, res.endereco)
+			}
+		}
+	}
+
+	if len(enderecos) == 0 {
+		return domain.ResultadoAgregado{}, fmt.Errorf("nenhum provedor respondeu com sucesso")
+	}
+
+	return domain.ResultadoAgregado{
+		Enderecos:     enderecos,
+		Discrepancias: compararEnderecos(enderecos),
+	}, nil
+}
@@ -0,0 +1,88 @@
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/willianfariabatista/janeiro_Desafio2_Multithreading/domain"
+)
+
+// viaCEPResponse representa a resposta da API: ViaCEP.
+type viaCEPResponse struct {
+	Cep        string `json:"cep"`
+	Logradouro string `json:"logradouro"`
+	Bairro     string `json:"bairro"`
+	Localidade string `json:"localidade"`
+	Uf         string `json:"uf"`
+}
+
+// viaCEPClient é usado quando ViaCEP.Client não é informado.
+var viaCEPClient = NewClient(DefaultClientConfig())
+
+// ViaCEP consulta o endereço de um CEP na viacep.com.br.
+type ViaCEP struct {
+	Client *Client // Se nil, usa um Client com ClientConfig padrão.
+}
+
+// Nome identifica este provedor.
+func (ViaCEP) Nome() string {
+	return "ViaCEP"
+}
+
+// Fetch faz a requisição para a API: viacep.com.br.
+func (p ViaCEP) Fetch(ctx context.Context, cep string) (domain.Endereco, error) {
+
+	client := p.Client
+	if client == nil {
+		client = viaCEPClient
+	}
+
+	endereco := domain.Endereco{}
+	url := fmt.Sprintf("http://viacep.com.br/ws/%s/json/", cep)
+
+	// Cria uma requisição HTTP GET com um contexto para buscar o endereço na API viacep.com.br.
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+
+	// Se houver erro na criação da requisição, retorna a estrutura de endereço vazia e o erro.
+	if err != nil {
+		return endereco, err
+	}
+
+	// Envia a requisição HTTP para obter os dados do endereço, com retry/backoff e rate limiting.
+	resp, err := client.Do(req)
+
+	// Se houver erro na requisição, retorna a estrutura de endereço vazia e o erro.
+	if err != nil {
+		return endereco, err
+	}
+
+	// Garante que o corpo da resposta HTTP seja fechado.
+	defer resp.Body.Close()
+
+	// Verifica se a resposta HTTP tem status diferente de 200 (OK). Se for diferente, retorna a estrutura de endereço vazia e uma mensagem de erro.
+	if resp.StatusCode != http.StatusOK {
+		return endereco, fmt.Errorf("ViaCEP retornou status %d", resp.StatusCode)
+	}
+
+	var data viaCEPResponse
+
+	// Decodifica o JSON da resposta HTTP para a estrutura viaCEPResponse. Se ocorrer um erro na decodificação, retorna a estrutura de endereço vazia e o erro.
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		return endereco, err
+	}
+
+	// Preenche a estrutura Endereco com os dados retornados pela API viacep.com.br.
+	endereco = domain.Endereco{
+		CEP:        data.Cep,
+		Logradouro: data.Logradouro,
+		Bairro:     data.Bairro,
+		Cidade:     data.Localidade,
+		UF:         data.Uf,
+		Fonte:      p.Nome(),
+	}
+
+	// Retorna a estrutura Endereco preenchida.
+	return endereco, nil
+}